@@ -0,0 +1,108 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions holds delete/list flows shared across resource commands'
+// "rm" subcommands (clustertask, task, pipeline, ...).
+package actions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+// Resource is implemented by a command's client wrapper so the shared
+// delete flow can list candidate names (for --all/-l) and remove one by
+// name, without actions knowing about the underlying CRD.
+type Resource interface {
+	ListNames(selector string) ([]string, error)
+	Delete(name string) error
+}
+
+// DeleteOptions configures the shared bulk/selector-aware delete flow.
+type DeleteOptions struct {
+	// Resource is the lower-case singular resource kind used in messages,
+	// e.g. "clustertask".
+	Resource string
+	// DisplayName is the capitalized form used in the per-item success
+	// message, e.g. "ClusterTask".
+	DisplayName string
+	// Names are the resources named explicitly on the command line.
+	Names []string
+	// All deletes every resource that Resource.ListNames returns.
+	All bool
+	// Selector, if set, restricts All (or is used on its own) to
+	// resources matching the label selector.
+	Selector string
+	// Force skips the confirmation prompt.
+	Force bool
+}
+
+// Delete resolves the set of resource names to remove (from --all/-l or the
+// explicit Names), confirms with the user unless Force is set, and deletes
+// each one. It does not abort on the first failure: remaining items are
+// still attempted, and any failures are returned as a single aggregated
+// error listing each failed name.
+func Delete(r Resource, s *cli.Stream, in io.Reader, opts DeleteOptions) error {
+	names := opts.Names
+	if opts.All || opts.Selector != "" {
+		var err error
+		names, err = r.ListNames(opts.Selector)
+		if err != nil {
+			return fmt.Errorf("failed to list %ss: %v", opts.Resource, err)
+		}
+		if len(names) == 0 {
+			fmt.Fprintf(s.Err, "No %ss found\n", opts.Resource)
+			return nil
+		}
+	}
+
+	if !opts.Force {
+		msg := fmt.Sprintf("%s %q", opts.Resource, names[0])
+		if len(names) > 1 {
+			msg = fmt.Sprintf("%d %ss", len(names), opts.Resource)
+		}
+		if err := cli.AskForConfirmation(msg, s, in); err != nil {
+			return err
+		}
+	}
+
+	var failed []string
+	var firstErr error
+	for _, name := range names {
+		if err := r.Delete(name); err != nil {
+			e := fmt.Errorf("Failed to delete %s %q: %s", opts.Resource, name, err)
+			if firstErr == nil {
+				firstErr = e
+			}
+			failed = append(failed, name)
+			continue
+		}
+		fmt.Fprintf(s.Out, "%s deleted: %s\n", opts.DisplayName, name)
+	}
+
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		if len(names) == 1 {
+			return firstErr
+		}
+		return fmt.Errorf("failed to delete %ss: %s", opts.Resource, strings.Join(failed, ", "))
+	default:
+		return fmt.Errorf("failed to delete %ss: %s", opts.Resource, strings.Join(failed, ", "))
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/discovery"
+)
+
+// PreferredAPIVersion asks the cluster's discovery API which versions of
+// group it actually serves, and returns the one preferenceOrder ranks
+// highest (later entries are preferred). This lets commands talk to
+// whichever CRD version (e.g. v1alpha1 vs v1beta1) a given cluster serves
+// without the user having to know or care.
+func PreferredAPIVersion(d discovery.DiscoveryInterface, group string, preferenceOrder []string) (string, error) {
+	resources, err := d.ServerGroups()
+	if err != nil {
+		return "", fmt.Errorf("failed to discover served versions for %q: %v", group, err)
+	}
+
+	var served []string
+	for _, g := range resources.Groups {
+		if g.Name != group {
+			continue
+		}
+		for _, v := range g.Versions {
+			served = append(served, v.Version)
+		}
+	}
+
+	for i := len(preferenceOrder) - 1; i >= 0; i-- {
+		for _, v := range served {
+			if v == preferenceOrder[i] {
+				return v, nil
+			}
+		}
+	}
+
+	if len(served) > 0 {
+		return served[0], nil
+	}
+
+	return "", fmt.Errorf("no served version found for API group %q", group)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPreferredAPIVersion(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		served  []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "prefers v1beta1 when both are served",
+			served: []string{"tekton.dev/v1alpha1", "tekton.dev/v1beta1"},
+			want:   "v1beta1",
+		},
+		{
+			name:   "falls back to v1alpha1 when v1beta1 isn't served",
+			served: []string{"tekton.dev/v1alpha1"},
+			want:   "v1alpha1",
+		},
+		{
+			name:    "errors when the group isn't served at all",
+			served:  []string{"other.example.dev/v1"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := kubefake.NewSimpleClientset()
+			cs.Resources = make([]*metav1.APIResourceList, 0, len(tc.served))
+			for _, gv := range tc.served {
+				cs.Resources = append(cs.Resources, &metav1.APIResourceList{GroupVersion: gv})
+			}
+
+			got, err := PreferredAPIVersion(cs.Discovery(), "tekton.dev", []string{"v1alpha1", "v1beta1"})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got version %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got version %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustertask
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+// Command returns a cobra command for managing ClusterTasks.
+func Command(p cli.Params) *cobra.Command {
+	c := &cobra.Command{
+		Use:     "clustertask",
+		Aliases: []string{"ct", "clustertasks"},
+		Short:   "Manage clustertasks",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+	}
+
+	c.AddCommand(
+		deleteCommand(p),
+	)
+
+	return c
+}
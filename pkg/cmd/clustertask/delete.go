@@ -0,0 +1,82 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustertask
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+func deleteCommand(p cli.Params) *cobra.Command {
+	var force, all bool
+	var selector, apiVersion string
+
+	eg := `Delete a clustertask of name 'foo':
+
+	tkn clustertask delete foo
+
+or delete every clustertask matching a label:
+
+	tkn clustertask delete -l app=buildpacks
+
+or delete every clustertask in the cluster:
+
+	tkn clustertask delete --all
+`
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a clustertask",
+		Example: eg,
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !all && selector == "" && len(args) == 0 {
+				return fmt.Errorf("requires a clustertask name, --all, or -l/--selector")
+			}
+			if len(args) > 0 && (all || selector != "") {
+				return fmt.Errorf("--all and -l/--selector cannot be used together with a clustertask name")
+			}
+
+			lister, err := newClusterTaskLister(p, apiVersion)
+			if err != nil {
+				return err
+			}
+
+			s := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			return actions.Delete(lister, s, cmd.InOrStdin(), actions.DeleteOptions{
+				Resource:    "clustertask",
+				DisplayName: "ClusterTask",
+				Names:       args,
+				All:         all,
+				Selector:    selector,
+				Force:       force,
+			})
+		},
+	}
+
+	c.Flags().BoolVarP(&force, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVar(&all, "all", false, "Delete all clustertasks in the cluster (default: false)")
+	c.Flags().StringVarP(&selector, "selector", "l", "", "A selector (label query) to filter on")
+	c.Flags().StringVar(&apiVersion, "api-version", "", "API version to use (defaults to the version negotiated with the cluster)")
+	return c
+}
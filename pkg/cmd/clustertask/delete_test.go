@@ -15,6 +15,7 @@
 package clustertask
 
 import (
+	"fmt"
 	"io"
 	"strings"
 	"testing"
@@ -24,8 +25,11 @@ import (
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	fakepipelineclientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
 	pipelinetest "github.com/tektoncd/pipeline/test"
 	tb "github.com/tektoncd/pipeline/test/builder"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stest "k8s.io/client-go/testing"
 )
 
 func TestClusterTaskDelete(t *testing.T) {
@@ -113,4 +117,130 @@ func TestClusterTaskDelete(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestClusterTaskDelete_AllAndSelector(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	seedClustertasks := func() pipelinetest.Clients {
+		clustertasks := []*v1alpha1.ClusterTask{
+			tb.ClusterTask("tomatoes", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute))),
+			tb.ClusterTask("potatoes", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute)),
+				tb.ClusterTaskLabels(map[string]string{"app": "buildpacks"})),
+			tb.ClusterTask("carrots", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute)),
+				tb.ClusterTaskLabels(map[string]string{"app": "buildpacks"})),
+		}
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{ClusterTasks: clustertasks})
+		return cs
+	}
+
+	testParams := []struct {
+		name        string
+		command     []string
+		inputStream io.Reader
+		wantError   bool
+		want        string
+	}{
+		{
+			name:        "Delete all with force",
+			command:     []string{"rm", "--all", "-f"},
+			inputStream: nil,
+			wantError:   false,
+			want:        "ClusterTask deleted: tomatoes\nClusterTask deleted: potatoes\nClusterTask deleted: carrots\n",
+		},
+		{
+			name:        "Delete by selector with force",
+			command:     []string{"rm", "-l", "app=buildpacks", "-f"},
+			inputStream: nil,
+			wantError:   false,
+			want:        "ClusterTask deleted: potatoes\nClusterTask deleted: carrots\n",
+		},
+		{
+			name:        "Delete all without force, reply yes, summarizes count",
+			command:     []string{"rm", "--all"},
+			inputStream: strings.NewReader("y"),
+			wantError:   false,
+			want:        "Are you sure you want to delete 3 clustertasks (y/n): ClusterTask deleted: tomatoes\nClusterTask deleted: potatoes\nClusterTask deleted: carrots\n",
+		},
+		{
+			name:        "Delete all without force, reply no, cancels",
+			command:     []string{"rm", "--all"},
+			inputStream: strings.NewReader("n"),
+			wantError:   true,
+			want:        "Canceled deleting 3 clustertasks",
+		},
+		{
+			name:        "Neither name, --all, nor -l given",
+			command:     []string{"rm"},
+			inputStream: nil,
+			wantError:   true,
+			want:        "requires a clustertask name, --all, or -l/--selector",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Tekton: seedClustertasks().Pipeline}
+			clustertask := Command(p)
+
+			if tp.inputStream != nil {
+				clustertask.SetIn(tp.inputStream)
+			}
+
+			out, err := test.ExecuteCommand(clustertask, tp.command...)
+			if tp.wantError {
+				if err == nil {
+					t.Errorf("Error expected here")
+				}
+				test.AssertOutput(t, tp.want, err.Error())
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected Error: %v", err)
+				}
+				test.AssertOutput(t, tp.want, out)
+			}
+		})
+	}
+}
+
+// TestClusterTaskDelete_PartialFailureAggregatesError asserts that a --all
+// delete doesn't abort on the first failing item: it keeps deleting the
+// rest and reports every failed name in one aggregated error.
+func TestClusterTaskDelete_PartialFailureAggregatesError(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	clustertasks := []*v1alpha1.ClusterTask{
+		tb.ClusterTask("tomatoes", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute))),
+		tb.ClusterTask("potatoes", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute))),
+		tb.ClusterTask("carrots", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute))),
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{ClusterTasks: clustertasks})
+
+	fakeClient, ok := cs.Pipeline.(*fakepipelineclientset.Clientset)
+	if !ok {
+		t.Fatalf("expected a fake clientset, got %T", cs.Pipeline)
+	}
+	fakeClient.PrependReactor("delete", "clustertasks", func(action k8stest.Action) (bool, runtime.Object, error) {
+		if action.(k8stest.DeleteAction).GetName() == "potatoes" {
+			return true, nil, fmt.Errorf("etcd timeout")
+		}
+		return false, nil, nil
+	})
+
+	p := &test.Params{Tekton: cs.Pipeline}
+	clustertask := Command(p)
+
+	out, err := test.ExecuteCommand(clustertask, "rm", "--all", "-f")
+	if err == nil {
+		t.Fatalf("expected an aggregated error")
+	}
+	test.AssertOutput(t, "failed to delete clustertasks: potatoes", err.Error())
+
+	for _, want := range []string{"ClusterTask deleted: tomatoes\n", "ClusterTask deleted: carrots\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the other items to still be deleted, missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "ClusterTask deleted: potatoes") {
+		t.Errorf("did not expect the failed delete to be reported as succeeded, got:\n%s", out)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustertask
+
+import (
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// apiVersionPreference is the order in which served tekton.dev versions are
+// preferred, lowest to highest.
+var apiVersionPreference = []string{"v1alpha1", "v1beta1"}
+
+// clusterTaskLister abstracts listing/deleting ClusterTasks across the
+// tekton.dev API versions this CLI understands, so callers don't
+// need to know which version the cluster actually serves.
+type clusterTaskLister interface {
+	ListNames(selector string) ([]string, error)
+	Delete(name string) error
+}
+
+type v1alpha1ClusterTasks struct {
+	client versioned.Interface
+}
+
+func (l *v1alpha1ClusterTasks) ListNames(selector string) ([]string, error) {
+	cts, err := l.client.TektonV1alpha1().ClusterTasks().List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(cts.Items))
+	for _, ct := range cts.Items {
+		names = append(names, ct.Name)
+	}
+	return names, nil
+}
+
+func (l *v1alpha1ClusterTasks) Delete(name string) error {
+	return l.client.TektonV1alpha1().ClusterTasks().Delete(name, &metav1.DeleteOptions{})
+}
+
+type v1beta1ClusterTasks struct {
+	client versioned.Interface
+}
+
+func (l *v1beta1ClusterTasks) ListNames(selector string) ([]string, error) {
+	cts, err := l.client.TektonV1beta1().ClusterTasks().List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(cts.Items))
+	for _, ct := range cts.Items {
+		names = append(names, ct.Name)
+	}
+	return names, nil
+}
+
+func (l *v1beta1ClusterTasks) Delete(name string) error {
+	return l.client.TektonV1beta1().ClusterTasks().Delete(name, &metav1.DeleteOptions{})
+}
+
+// newClusterTaskLister resolves the clusterTaskLister to use: apiVersion if
+// explicitly set (via --api-version), otherwise the version negotiated from
+// the cluster's discovery API.
+func newClusterTaskLister(p cli.Params, apiVersion string) (clusterTaskLister, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+
+	version := apiVersion
+	if version == "" {
+		version, err = cli.PreferredAPIVersion(cs.Discovery, "tekton.dev", apiVersionPreference)
+		if err != nil {
+			version = "v1alpha1"
+		}
+	}
+
+	if version == "v1beta1" {
+		return &v1beta1ClusterTasks{client: cs.Tekton}, nil
+	}
+	return &v1alpha1ClusterTasks{client: cs.Tekton}, nil
+}
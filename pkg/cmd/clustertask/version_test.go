@@ -0,0 +1,69 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustertask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	tb "github.com/tektoncd/pipeline/test/builder"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestClusterTaskDelete_APIVersions runs the same force-delete scenario
+// against a cluster seeded only through v1alpha1 and one seeded only
+// through v1beta1, pinned via --api-version, so both adapters' happy paths
+// are exercised rather than just the v1alpha1 one.
+func TestClusterTaskDelete_APIVersions(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	t.Run("v1alpha1", func(t *testing.T) {
+		clustertasks := []*v1alpha1.ClusterTask{
+			tb.ClusterTask("tomatoes", cb.ClusterTaskCreationTime(clock.Now().Add(-1*time.Minute))),
+		}
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{ClusterTasks: clustertasks})
+
+		p := &test.Params{Tekton: cs.Pipeline}
+		clustertask := Command(p)
+
+		_, err := test.ExecuteCommand(clustertask, "rm", "tomatoes", "-f", "--api-version", "v1alpha1")
+		if err != nil {
+			t.Errorf("Unexpected Error: %v", err)
+		}
+	})
+
+	t.Run("v1beta1", func(t *testing.T) {
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+		if _, err := cs.Pipeline.TektonV1beta1().ClusterTasks().Create(&v1beta1.ClusterTask{
+			ObjectMeta: metav1.ObjectMeta{Name: "tomatoes"},
+		}); err != nil {
+			t.Fatalf("failed to seed v1beta1 clustertask: %v", err)
+		}
+
+		p := &test.Params{Tekton: cs.Pipeline}
+		clustertask := Command(p)
+
+		_, err := test.ExecuteCommand(clustertask, "rm", "tomatoes", "-f", "--api-version", "v1beta1")
+		if err != nil {
+			t.Errorf("Unexpected Error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cmd/clustertask"
+	"github.com/tektoncd/cli/pkg/cmd/condition"
+	"github.com/tektoncd/cli/pkg/cmd/eventlistener"
+)
+
+// Root returns the top level "tkn" command with all command groups wired in.
+func Root(p cli.Params) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "tkn",
+		Short:        "CLI for tekton pipelines",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(
+		clustertask.Command(p),
+		condition.Command(p),
+		eventlistener.Command(p),
+	)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteCommand(p cli.Params) *cobra.Command {
+	var force bool
+
+	eg := `Delete a condition of name 'foo' in namespace 'bar':
+
+	tkn condition delete foo -n bar
+`
+
+	c := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a condition",
+		Example: eg,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			name := args[0]
+
+			if !force {
+				if err := cli.AskForConfirmation(fmt.Sprintf("condition %q", name), s, cmd.InOrStdin()); err != nil {
+					return err
+				}
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			if err := cs.Tekton.TektonV1alpha1().Conditions(p.Namespace()).Delete(name, &metav1.DeleteOptions{}); err != nil {
+				return fmt.Errorf("Failed to delete condition %q: %v", name, err)
+			}
+			fmt.Fprintf(s.Out, "Condition deleted: %s\n", name)
+			return nil
+		},
+	}
+
+	c.Flags().BoolVarP(&force, "force", "f", false, "Whether to force deletion (default: false)")
+	return c
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func describeCommand(p cli.Params) *cobra.Command {
+	eg := `Describe a condition of name 'foo' in namespace 'bar':
+
+	tkn condition describe foo -n bar
+`
+
+	c := &cobra.Command{
+		Use:     "describe",
+		Aliases: []string{"desc"},
+		Short:   "Describe a condition",
+		Example: eg,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			cond, err := cs.Tekton.TektonV1alpha1().Conditions(p.Namespace()).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to find condition %q: %v", args[0], err)
+			}
+
+			stream := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			return printConditionDescription(stream, cond, p)
+		},
+	}
+
+	return c
+}
+
+func printConditionDescription(s *cli.Stream, cond *v1alpha1.Condition, p cli.Params) error {
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+
+	fmt.Fprintf(w, "Name:\t%s\n", cond.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", cond.Namespace)
+	fmt.Fprintf(w, "Age:\t%s\n", formatted.Age(&cond.CreationTimestamp, p.Time()))
+	fmt.Fprintf(w, "Check Image:\t%s\n", cond.Spec.Check.Image)
+	if len(cond.Spec.Check.Command) > 0 {
+		fmt.Fprintf(w, "Check Command:\t%s\n", cond.Spec.Check.Command)
+	}
+	if len(cond.Spec.Check.Args) > 0 {
+		fmt.Fprintf(w, "Check Args:\t%s\n", cond.Spec.Check.Args)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Params")
+	if len(cond.Spec.Params) == 0 {
+		fmt.Fprintln(w, " No params")
+	} else {
+		fmt.Fprintln(w, " NAME\tTYPE\tDEFAULT VALUE")
+		for _, param := range cond.Spec.Params {
+			fmt.Fprintf(w, " %s\t%s\t%s\n", param.Name, param.Type, paramDefault(param))
+		}
+	}
+
+	return w.Flush()
+}
+
+func paramDefault(param v1alpha1.ParamSpec) string {
+	if param.Default == nil {
+		return "-"
+	}
+	if param.Default.Type == v1alpha1.ParamTypeArray {
+		return strings.Join(param.Default.ArrayVal, ", ")
+	}
+	return param.Default.StringVal
+}
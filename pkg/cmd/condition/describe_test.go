@@ -0,0 +1,101 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+)
+
+func TestConditionDescribe_NoParams(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	conditions := []*v1alpha1.Condition{
+		cb.Condition("is-main-branch",
+			cb.ConditionCreationTime(clock.Now().Add(-1*time.Minute)),
+			cb.ConditionCheck("gcr.io/example/branch-check", "check-branch.sh"),
+		),
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Conditions: conditions})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock}
+	condition := Command(p)
+
+	out, err := test.ExecuteCommand(condition, "describe", "is-main-branch")
+	if err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+
+	want := "Name:            is-main-branch\n" +
+		"Namespace:       \n" +
+		"Age:             1 minute ago\n" +
+		"Check Image:     gcr.io/example/branch-check\n" +
+		"Check Command:   [check-branch.sh]\n" +
+		"\n" +
+		"Params\n" +
+		" No params\n"
+	test.AssertOutput(t, want, out)
+}
+
+func TestConditionDescribe_WithParams(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	conditions := []*v1alpha1.Condition{
+		cb.Condition("is-pull-request",
+			cb.ConditionCreationTime(clock.Now().Add(-2*time.Minute)),
+			cb.ConditionCheck("gcr.io/example/pr-check"),
+			cb.ConditionParam("branch", "master"),
+		),
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Conditions: conditions})
+
+	p := &test.Params{Tekton: cs.Pipeline}
+	condition := Command(p)
+
+	out, err := test.ExecuteCommand(condition, "describe", "is-pull-request")
+	if err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+
+	for _, want := range []string{
+		"Name:          is-pull-request",
+		"Check Image:   gcr.io/example/pr-check",
+		"Params",
+		" NAME     TYPE     DEFAULT VALUE",
+		" branch   string   master",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("describe output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestConditionDescribe_NotFound(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+
+	p := &test.Params{Tekton: cs.Pipeline}
+	condition := Command(p)
+
+	if _, err := test.ExecuteCommand(condition, "describe", "nonexistent"); err == nil {
+		t.Errorf("Error expected here")
+	}
+}
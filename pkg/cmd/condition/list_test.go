@@ -0,0 +1,58 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+)
+
+func TestConditionList(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	conditions := []*v1alpha1.Condition{
+		cb.Condition("is-main-branch", cb.ConditionCreationTime(clock.Now().Add(-1*time.Minute))),
+		cb.Condition("is-pull-request", cb.ConditionCreationTime(clock.Now().Add(-2*time.Minute))),
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Conditions: conditions})
+
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock}
+	condition := Command(p)
+
+	out, err := test.ExecuteCommand(condition, "list")
+	if err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+	test.AssertOutput(t, "NAME              AGE\nis-main-branch    1 minute ago\nis-pull-request   2 minutes ago\n", out)
+}
+
+func TestConditionList_Empty(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+
+	p := &test.Params{Tekton: cs.Pipeline}
+	condition := Command(p)
+
+	out, err := test.ExecuteCommand(condition, "list")
+	if err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+	test.AssertOutput(t, "", out)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func logsCommand(p cli.Params) *cobra.Command {
+	opts := struct {
+		taskRun     string
+		pipelineRun string
+		condition   string
+	}{}
+
+	eg := `Show the logs of the condition check "guard" for TaskRun 'build-task' in PipelineRun 'build-pr' in namespace 'bar':
+
+	tkn condition logs --pipelinerun build-pr --taskrun build-task --condition guard -n bar
+`
+
+	c := &cobra.Command{
+		Use:     "logs",
+		Short:   "Show logs of a condition check",
+		Example: eg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.pipelineRun == "" {
+				return fmt.Errorf("--pipelinerun must be provided")
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			podName, err := conditionCheckPodName(cs, p.Namespace(), opts.pipelineRun, opts.taskRun, opts.condition)
+			if err != nil {
+				return err
+			}
+
+			req := cs.Kube.CoreV1().Pods(p.Namespace()).GetLogs(podName, &corev1.PodLogOptions{})
+			logs, err := req.Stream()
+			if err != nil {
+				return fmt.Errorf("failed to get logs for condition check pod %q: %v", podName, err)
+			}
+			defer logs.Close()
+
+			stream := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			_, err = io.Copy(stream.Out, logs)
+			return err
+		},
+	}
+
+	c.Flags().StringVar(&opts.pipelineRun, "pipelinerun", "", "PipelineRun the condition check belongs to")
+	c.Flags().StringVar(&opts.taskRun, "taskrun", "", "Restrict to the ConditionChecks of this TaskRun within the PipelineRun")
+	c.Flags().StringVar(&opts.condition, "condition", "", "Name of the condition to show logs for")
+	return c
+}
+
+// conditionCheckPodName resolves the pod backing a ConditionCheck. Condition
+// checks are only surfaced on PipelineRun.Status.TaskRuns[name].ConditionChecks
+// (there is no such field on a standalone TaskRunStatus), so a PipelineRun is
+// always required; taskRun and condition narrow down which check to use.
+func conditionCheckPodName(cs *cli.Clients, ns, pipelineRun, taskRun, condition string) (string, error) {
+	pr, err := cs.Tekton.TektonV1alpha1().PipelineRuns(ns).Get(pipelineRun, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to find pipelinerun %q: %v", pipelineRun, err)
+	}
+
+	for trName, trs := range pr.Status.TaskRuns {
+		if taskRun != "" && trName != taskRun {
+			continue
+		}
+		for name, check := range trs.ConditionChecks {
+			if condition != "" && name != condition {
+				continue
+			}
+			if check.Status == nil {
+				return "", fmt.Errorf("condition check %q for pipelinerun %q has not started yet", name, pipelineRun)
+			}
+			return check.Status.PodName, nil
+		}
+	}
+	return "", fmt.Errorf("no condition checks found for pipelinerun %q", pipelineRun)
+}
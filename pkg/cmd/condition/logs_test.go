@@ -0,0 +1,97 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package condition
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func pipelineRunWithConditionCheck(name, taskRun, condition string, status *v1alpha1.ConditionCheckStatus) *v1alpha1.PipelineRun {
+	return &v1alpha1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Status: v1alpha1.PipelineRunStatus{
+			TaskRuns: map[string]*v1alpha1.PipelineRunTaskRunStatus{
+				taskRun: {
+					ConditionChecks: map[string]*v1alpha1.PipelineRunConditionCheckStatus{
+						condition: {
+							ConditionCheckName: condition,
+							Status:             status,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConditionLogs(t *testing.T) {
+	pr := pipelineRunWithConditionCheck("build-pr", "build-task", "guard", &v1alpha1.ConditionCheckStatus{
+		PodName: "guard-pod",
+	})
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: []*v1alpha1.PipelineRun{pr}})
+	if _, err := cs.Kube.CoreV1().Pods("ns").Create(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "guard-pod", Namespace: "ns"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}); err != nil {
+		t.Fatalf("failed to seed pod: %v", err)
+	}
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	condition := Command(p)
+
+	if _, err := test.ExecuteCommand(condition, "logs", "--pipelinerun", "build-pr", "--taskrun", "build-task", "--condition", "guard", "-n", "ns"); err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+}
+
+func TestConditionLogs_MissingPipelineRunFlag(t *testing.T) {
+	p := &test.Params{}
+	condition := Command(p)
+
+	if _, err := test.ExecuteCommand(condition, "logs"); err == nil {
+		t.Errorf("Error expected here")
+	}
+}
+
+func TestConditionLogs_ConditionCheckNotStarted(t *testing.T) {
+	pr := pipelineRunWithConditionCheck("build-pr", "build-task", "guard", nil)
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: []*v1alpha1.PipelineRun{pr}})
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	condition := Command(p)
+
+	_, err := test.ExecuteCommand(condition, "logs", "--pipelinerun", "build-pr", "--taskrun", "build-task", "--condition", "guard", "-n", "ns")
+	if err == nil {
+		t.Fatalf("expected an error for a condition check that has not started yet")
+	}
+	test.AssertOutput(t, `condition check "guard" for pipelinerun "build-pr" has not started yet`, err.Error())
+}
+
+func TestConditionLogs_PipelineRunNotFound(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	condition := Command(p)
+
+	if _, err := test.ExecuteCommand(condition, "logs", "--pipelinerun", "nonexistent", "-n", "ns"); err == nil {
+		t.Errorf("Error expected here")
+	}
+}
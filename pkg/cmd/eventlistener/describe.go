@@ -0,0 +1,223 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/printer"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func describeCommand(p cli.Params) *cobra.Command {
+	f := cliopts.NewPrintFlags("describe")
+	var showEvents bool
+
+	eg := `Describe an eventlistener of name 'foo' in namespace 'bar':
+
+	tkn eventlistener describe foo -n bar
+
+or
+
+	tkn el desc foo -n bar
+`
+
+	c := &cobra.Command{
+		Use:     "describe",
+		Aliases: []string{"desc"},
+		Short:   "Describe an eventlistener",
+		Example: eg,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			el, err := cs.Triggers.TektonV1alpha1().EventListeners(p.Namespace()).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to find eventlistener %q: %v", args[0], err)
+			}
+
+			// NOTE: this is required for -o json|yaml to work properly since
+			// tektoncd go client fails to set these; probably a bug
+			el.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
+				Group:   "triggers.tekton.dev",
+				Version: "v1alpha1",
+				Kind:    "EventListener",
+			})
+
+			output, err := cmd.LocalFlags().GetString("output")
+			if err != nil {
+				return errors.New(`output option not set properly \n`)
+			}
+
+			stream := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			if output != "" {
+				return printer.PrintObject(stream.Out, el, f)
+			}
+
+			var events []string
+			if showEvents {
+				events, err = backingEvents(cs, p.Namespace(), el)
+				if err != nil {
+					return err
+				}
+			}
+
+			return printEventListenerDescription(stream, el, p, events)
+		},
+	}
+
+	f.AddFlags(c)
+	c.Flags().BoolVar(&showEvents, "show-events", false, "Show Kubernetes events for the backing Deployment and Service")
+	return c
+}
+
+func backingEvents(cs *cli.Clients, ns string, el *v1alpha1.EventListener) ([]string, error) {
+	generatedName := el.Status.Configuration.GeneratedResourceName
+	if generatedName == "" {
+		return nil, nil
+	}
+
+	var out []string
+	for _, kind := range []string{"Deployment", "Service"} {
+		selector := fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, generatedName)
+		events, err := cs.Kube.CoreV1().Events(ns).List(metav1.ListOptions{FieldSelector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch events for %s %q: %v", kind, generatedName, err)
+		}
+		for _, ev := range events.Items {
+			out = append(out, fmt.Sprintf("%s\t%s\t%s", kind, ev.Reason, ev.Message))
+		}
+	}
+
+	return out, nil
+}
+
+func printEventListenerDescription(s *cli.Stream, el *v1alpha1.EventListener, p cli.Params, events []string) error {
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+
+	fmt.Fprintf(w, "Name:\t%s\n", el.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", el.Namespace)
+	fmt.Fprintf(w, "Age:\t%s\n", formatted.Age(&el.CreationTimestamp, p.Time()))
+	if len(el.Labels) > 0 {
+		fmt.Fprintf(w, "Labels:\t%s\n", formatted.Labels(el.Labels))
+	}
+	fmt.Fprintf(w, "ServiceAccount:\t%s\n", el.Spec.ServiceAccountName)
+
+	sinkURL := ""
+	if el.Status.Address != nil && el.Status.Address.URL != nil {
+		sinkURL = el.Status.Address.URL.String()
+	}
+	fmt.Fprintf(w, "URL:\t%s\n", sinkURL)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Conditions")
+	if len(el.Status.Conditions) == 0 {
+		fmt.Fprintln(w, " No conditions")
+	} else {
+		fmt.Fprintln(w, " TYPE\tSTATUS\tMESSAGE")
+		for _, c := range el.Status.Conditions {
+			fmt.Fprintf(w, " %s\t%s\t%s\n", c.Type, c.Status, c.Message)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Triggers")
+	if len(el.Spec.Triggers) == 0 {
+		fmt.Fprintln(w, " No triggers")
+	} else {
+		fmt.Fprintln(w, " NAME\tINTERCEPTORS\tBINDINGS\tTEMPLATE")
+		for _, t := range el.Spec.Triggers {
+			fmt.Fprintf(w, " %s\t%s\t%s\t%s\n",
+				triggerName(t),
+				interceptorNames(t),
+				bindingNames(t),
+				templateName(t))
+		}
+	}
+
+	if len(events) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Events")
+		fmt.Fprintln(w, " KIND\tREASON\tMESSAGE")
+		for _, ev := range events {
+			fmt.Fprintf(w, " %s\n", ev)
+		}
+	}
+
+	return w.Flush()
+}
+
+func triggerName(t v1alpha1.EventListenerTrigger) string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return "-"
+}
+
+func interceptorNames(t v1alpha1.EventListenerTrigger) string {
+	if len(t.Interceptors) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(t.Interceptors))
+	for _, i := range t.Interceptors {
+		switch {
+		case i.Webhook != nil:
+			names = append(names, "webhook")
+		case i.GitHub != nil:
+			names = append(names, "github")
+		case i.GitLab != nil:
+			names = append(names, "gitlab")
+		case i.CEL != nil:
+			names = append(names, "cel")
+		default:
+			names = append(names, "unknown")
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func bindingNames(t v1alpha1.EventListenerTrigger) string {
+	if len(t.Bindings) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(t.Bindings))
+	for _, b := range t.Bindings {
+		names = append(names, b.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func templateName(t v1alpha1.EventListenerTrigger) string {
+	if t.Template.Name == "" {
+		return "-"
+	}
+	return t.Template.Name
+}
@@ -0,0 +1,205 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	faketriggers "github.com/tektoncd/triggers/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEventListenerDescribe_NoTriggers(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	el := &v1alpha1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "no-triggers",
+			Namespace:         "ns",
+			CreationTimestamp: metav1.Time{Time: clock.Now().Add(-2 * time.Minute)},
+		},
+	}
+
+	p := &test.Params{Triggers: faketriggers.NewSimpleClientset(el)}
+	cmd := Command(p)
+
+	out, err := test.ExecuteCommand(cmd, "describe", "no-triggers", "-n", "ns")
+	if err != nil {
+		t.Fatalf("Unexpected Error: %v", err)
+	}
+
+	for _, want := range []string{
+		"Name:             no-triggers",
+		"Namespace:        ns",
+		"No conditions",
+		"No triggers",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("describe output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestEventListenerDescribe_MultipleTriggersWithInterceptorsAndBindings(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	el := &v1alpha1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "webhooks",
+			Namespace:         "ns",
+			CreationTimestamp: metav1.Time{Time: clock.Now().Add(-2 * time.Minute)},
+		},
+		Spec: v1alpha1.EventListenerSpec{
+			ServiceAccountName: "tekton-triggers",
+			Triggers: []v1alpha1.EventListenerTrigger{
+				{
+					Name: "build-on-push",
+					Interceptors: []*v1alpha1.EventInterceptor{
+						{Webhook: &v1alpha1.WebhookInterceptor{}},
+						{GitHub: &v1alpha1.GitHubInterceptor{}},
+					},
+					Bindings: []*v1alpha1.EventListenerBinding{
+						{Name: "push-binding"},
+						{Name: "repo-binding"},
+					},
+					Template: v1alpha1.EventListenerTemplate{Name: "build-template"},
+				},
+				{
+					Name: "release-on-tag",
+					Interceptors: []*v1alpha1.EventInterceptor{
+						{CEL: &v1alpha1.CELInterceptor{}},
+					},
+					Bindings: []*v1alpha1.EventListenerBinding{
+						{Name: "tag-binding"},
+					},
+					Template: v1alpha1.EventListenerTemplate{Name: "release-template"},
+				},
+			},
+		},
+	}
+
+	p := &test.Params{Triggers: faketriggers.NewSimpleClientset(el)}
+	cmd := Command(p)
+
+	out, err := test.ExecuteCommand(cmd, "describe", "webhooks", "-n", "ns")
+	if err != nil {
+		t.Fatalf("Unexpected Error: %v", err)
+	}
+
+	for _, want := range []string{
+		"ServiceAccount:   tekton-triggers",
+		"build-on-push",
+		"webhook, github",
+		"push-binding, repo-binding",
+		"build-template",
+		"release-on-tag",
+		"cel",
+		"tag-binding",
+		"release-template",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("describe output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestEventListenerDescribe_NotFound(t *testing.T) {
+	p := &test.Params{Triggers: faketriggers.NewSimpleClientset()}
+	cmd := Command(p)
+
+	if _, err := test.ExecuteCommand(cmd, "describe", "nonexistent", "-n", "ns"); err == nil {
+		t.Errorf("Error expected here")
+	}
+}
+
+func TestEventListenerDescribe_StructuredOutput(t *testing.T) {
+	el := &v1alpha1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "ns"},
+	}
+
+	for _, tc := range []struct {
+		output string
+		want   []string
+	}{
+		{
+			output: "yaml",
+			want:   []string{"apiVersion: triggers.tekton.dev/v1alpha1", "kind: EventListener", "name: foo"},
+		},
+		{
+			output: "json",
+			want:   []string{`"apiVersion": "triggers.tekton.dev/v1alpha1"`, `"kind": "EventListener"`, `"name": "foo"`},
+		},
+	} {
+		t.Run(tc.output, func(t *testing.T) {
+			p := &test.Params{Triggers: faketriggers.NewSimpleClientset(el)}
+			cmd := Command(p)
+
+			out, err := test.ExecuteCommand(cmd, "describe", "foo", "-n", "ns", "-o", tc.output)
+			if err != nil {
+				t.Fatalf("Unexpected Error: %v", err)
+			}
+
+			for _, want := range tc.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("%s output missing %q, got:\n%s", tc.output, want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestEventListenerDescribe_ShowEvents(t *testing.T) {
+	el := &v1alpha1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "ns"},
+		Status: v1alpha1.EventListenerStatus{
+			Configuration: v1alpha1.EventListenerConfig{GeneratedResourceName: "el-foo-deploy"},
+		},
+	}
+
+	events := []runtime.Object{
+		&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo.1", Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Deployment",
+				Name: "el-foo-deploy",
+			},
+			Reason:  "FailedScheduling",
+			Message: "0/3 nodes available",
+		},
+	}
+
+	p := &test.Params{
+		Triggers: faketriggers.NewSimpleClientset(el),
+		Kube:     kubefake.NewSimpleClientset(events...),
+	}
+	cmd := Command(p)
+
+	out, err := test.ExecuteCommand(cmd, "describe", "foo", "-n", "ns", "--show-events")
+	if err != nil {
+		t.Fatalf("Unexpected Error: %v", err)
+	}
+
+	for _, want := range []string{"Events", "FailedScheduling", "0/3 nodes available"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("describe output missing %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package eventlistener
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -25,9 +26,7 @@ import (
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/cli/pkg/validate"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
-	"github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -37,6 +36,8 @@ const (
 
 func listCommand(p cli.Params) *cobra.Command {
 	f := cliopts.NewPrintFlags("list")
+	var apiVersion, selector string
+	var allNamespaces bool
 
 	eg := `List all eventlisteners in namespace 'bar':
 
@@ -45,6 +46,10 @@ func listCommand(p cli.Params) *cobra.Command {
 or
 
 	tkn el ls -n bar
+
+or list eventlisteners matching a label across every namespace:
+
+	tkn eventlistener list -A -l app=buildpacks
 `
 
 	c := &cobra.Command{
@@ -56,20 +61,28 @@ or
 		},
 		Example: eg,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := validate.NamespaceExists(p); err != nil {
+			namespace := p.Namespace()
+			if allNamespaces {
+				namespace = ""
+			} else if err := validate.NamespaceExists(p); err != nil {
 				return err
 			}
 
-			cs, err := p.Clients()
+			lister, err := newEventListenerLister(p, apiVersion)
 			if err != nil {
 				return err
 			}
 
-			els, err := list(cs.Triggers, p.Namespace())
+			els, err := lister.List(namespace, metav1.ListOptions{LabelSelector: selector})
 			if err != nil {
-				return fmt.Errorf(`failed to list eventlisteners from %s namespace \n`, p.Namespace())
+				if allNamespaces {
+					return fmt.Errorf(`failed to list eventlisteners from all namespaces \n`)
+				}
+				return fmt.Errorf(`failed to list eventlisteners from %s namespace \n`, namespace)
 			}
 
+			sortByNamespaceAndName(els)
+
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
 				return errors.New(`output option not set properly \n`)
@@ -84,7 +97,7 @@ or
 				return printer.PrintObject(stream.Out, els, f)
 			}
 
-			if err = printFormatted(stream, els, p); err != nil {
+			if err = printFormatted(stream, els, p, allNamespaces); err != nil {
 				return errors.New(`failed to print eventlisteners \n`)
 			}
 			return nil
@@ -92,33 +105,40 @@ or
 	}
 
 	f.AddFlags(c)
+	c.Flags().StringVar(&apiVersion, "api-version", "", "API version to use (defaults to the version negotiated with the cluster)")
+	c.Flags().StringVarP(&selector, "selector", "l", "", "A selector (label query) to filter on")
+	c.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List eventlisteners in all namespaces")
 	return c
 }
 
-func list(client versioned.Interface, namespace string) (*v1alpha1.EventListenerList, error) {
-	els, err := client.TektonV1alpha1().EventListeners(namespace).List(metav1.ListOptions{})
-	if err != nil {
-		return nil, err
-	}
-
-	// NOTE: this is required for -o json|yaml to work properly since
-	// tektoncd go client fails to set these; probably a bug
-	els.GetObjectKind().SetGroupVersionKind(
-		schema.GroupVersionKind{
-			Version: "tekton.dev/v1alpha1",
-			Kind:    "EventListenerList",
-		})
-
-	return els, nil
+func sortByNamespaceAndName(els *v1alpha1.EventListenerList) {
+	sort.Slice(els.Items, func(i, j int) bool {
+		if els.Items[i].Namespace != els.Items[j].Namespace {
+			return els.Items[i].Namespace < els.Items[j].Namespace
+		}
+		return els.Items[i].Name < els.Items[j].Name
+	})
 }
 
-func printFormatted(s *cli.Stream, els *v1alpha1.EventListenerList, p cli.Params) error {
+func printFormatted(s *cli.Stream, els *v1alpha1.EventListenerList, p cli.Params, allNamespaces bool) error {
 	if len(els.Items) == 0 {
 		fmt.Fprintln(s.Err, emptyMsg)
 		return nil
 	}
 
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	if allNamespaces {
+		fmt.Fprintln(w, "NAMESPACE\tNAME\tAGE")
+		for _, el := range els.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\n",
+				el.Namespace,
+				el.Name,
+				formatted.Age(&el.CreationTimestamp, p.Time()),
+			)
+		}
+		return w.Flush()
+	}
+
 	fmt.Fprintln(w, "NAME\tAGE")
 	for _, el := range els.Items {
 		fmt.Fprintf(w, "%s\t%s\n",
@@ -0,0 +1,123 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	faketriggers "github.com/tektoncd/triggers/pkg/client/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func eventListener(name, ns string, age time.Duration, labels map[string]string, clock clockwork.FakeClock) *v1alpha1.EventListener {
+	return &v1alpha1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         ns,
+			Labels:            labels,
+			CreationTimestamp: metav1.Time{Time: clock.Now().Add(-age)},
+		},
+	}
+}
+
+func TestEventListenerList_AllNamespacesAndSelector(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	objs := []runtime.Object{
+		eventListener("foo", "ns-a", time.Minute, map[string]string{"app": "buildpacks"}, clock),
+		eventListener("bar", "ns-b", 2*time.Minute, nil, clock),
+		eventListener("baz", "ns-c", 3*time.Minute, map[string]string{"app": "buildpacks"}, clock),
+	}
+
+	t.Run("all namespaces, sorted by namespace then name", func(t *testing.T) {
+		p := &test.Params{Triggers: faketriggers.NewSimpleClientset(objs...)}
+		el := Command(p)
+
+		out, err := test.ExecuteCommand(el, "list", "-A")
+		if err != nil {
+			t.Fatalf("Unexpected Error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+		if len(lines) != 4 {
+			t.Fatalf("expected a header and 3 rows, got %d lines:\n%s", len(lines), out)
+		}
+		if !strings.HasPrefix(lines[0], "NAMESPACE   NAME   AGE") {
+			t.Errorf("unexpected header: %q", lines[0])
+		}
+		for i, want := range []string{"ns-a        foo    ", "ns-b        bar    ", "ns-c        baz    "} {
+			if !strings.HasPrefix(lines[i+1], want) {
+				t.Errorf("row %d: got %q, want prefix %q", i+1, lines[i+1], want)
+			}
+		}
+	})
+
+	t.Run("all namespaces with label selector", func(t *testing.T) {
+		p := &test.Params{Triggers: faketriggers.NewSimpleClientset(objs...)}
+		el := Command(p)
+
+		out, err := test.ExecuteCommand(el, "list", "-A", "-l", "app=buildpacks")
+		if err != nil {
+			t.Fatalf("Unexpected Error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("expected a header and 2 rows, got %d lines:\n%s", len(lines), out)
+		}
+		for i, want := range []string{"ns-a        foo    ", "ns-c        baz    "} {
+			if !strings.HasPrefix(lines[i+1], want) {
+				t.Errorf("row %d: got %q, want prefix %q", i+1, lines[i+1], want)
+			}
+		}
+		if strings.Contains(out, "ns-b") || strings.Contains(out, "bar") {
+			t.Errorf("selector should have excluded ns-b/bar, got:\n%s", out)
+		}
+	})
+
+	t.Run("structured output", func(t *testing.T) {
+		p := &test.Params{Triggers: faketriggers.NewSimpleClientset(objs...)}
+		el := Command(p)
+
+		out, err := test.ExecuteCommand(el, "list", "-A", "-o", "json")
+		if err != nil {
+			t.Fatalf("Unexpected Error: %v", err)
+		}
+		for _, want := range []string{`"kind": "EventListenerList"`, `"name": "foo"`, `"name": "bar"`, `"name": "baz"`} {
+			if !strings.Contains(out, want) {
+				t.Errorf("json output missing %q, got:\n%s", want, out)
+			}
+		}
+	})
+}
+
+func TestEventListenerList_Empty(t *testing.T) {
+	p := &test.Params{Triggers: faketriggers.NewSimpleClientset()}
+	el := Command(p)
+
+	out, err := test.ExecuteCommand(el, "list")
+	if err != nil {
+		t.Fatalf("Unexpected Error: %v", err)
+	}
+	if out != "" {
+		t.Errorf("expected no stdout output when there are no eventlisteners, got: %q", out)
+	}
+}
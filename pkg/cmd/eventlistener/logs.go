@@ -0,0 +1,187 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventListenerPodSelector is the label the Triggers controller sets on the
+// Deployment and its Pods backing an EventListener sink.
+const eventListenerPodSelector = "eventlistener"
+
+type logOpts struct {
+	container string
+	follow    bool
+	tail      int64
+	since     time.Duration
+	allPods   bool
+}
+
+func logsCommand(p cli.Params) *cobra.Command {
+	opts := &logOpts{}
+
+	eg := `Show logs of the eventlistener 'foo' sink pod in namespace 'bar':
+
+	tkn eventlistener logs foo -n bar
+
+or stream logs from every replica:
+
+	tkn eventlistener logs foo -n bar --all-pods -f
+`
+
+	c := &cobra.Command{
+		Use:     "logs",
+		Short:   "Show logs of an eventlistener sink pod",
+		Example: eg,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			stream := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+
+			return streamEventListenerLogs(cs, stream, p.Namespace(), args[0], opts)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.container, "container", "c", "", "Container to stream logs from")
+	c.Flags().BoolVarP(&opts.follow, "follow", "f", false, "Stream live logs")
+	c.Flags().Int64Var(&opts.tail, "tail", 0, "Lines of recent log to show (0 for all)")
+	c.Flags().DurationVar(&opts.since, "since", 0, "Show logs since a relative duration like 5m")
+	c.Flags().BoolVar(&opts.allPods, "all-pods", false, "Interleave logs from every replica of the sink Deployment")
+	return c
+}
+
+func streamEventListenerLogs(cs *cli.Clients, s *cli.Stream, ns, name string, opts *logOpts) error {
+	selector := fmt.Sprintf("%s=%s", eventListenerPodSelector, name)
+	pods, err := cs.Kube.CoreV1().Pods(ns).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list pods for eventlistener %q: %v", name, err)
+	}
+
+	ready := readyPods(pods.Items)
+	if len(ready) == 0 {
+		return fmt.Errorf("no running sink pod found for eventlistener %q", name)
+	}
+
+	if !opts.allPods {
+		ready = ready[:1]
+	}
+
+	logOpts := &corev1.PodLogOptions{
+		Container: opts.container,
+		Follow:    opts.follow,
+	}
+	if opts.tail > 0 {
+		logOpts.TailLines = &opts.tail
+	}
+	if opts.since > 0 {
+		since := int64(opts.since.Seconds())
+		logOpts.SinceSeconds = &since
+	}
+
+	if len(ready) == 1 {
+		return streamPodLogs(s.Out, cs, ns, ready[0].Name, logOpts, opts.allPods)
+	}
+
+	// With --follow, GetLogs().Stream() never returns for a live pod, so a
+	// serial loop would never reach replica 2+. Stream every pod
+	// concurrently instead, synchronizing writes to s.Out so interleaved
+	// lines don't tear.
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errOnce sync.Once
+		runErr  error
+	)
+
+	for _, pod := range ready {
+		pod := pod
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := streamPodLogs(&mutexWriter{mu: &mu, w: s.Out}, cs, ns, pod.Name, logOpts, opts.allPods); err != nil {
+				errOnce.Do(func() { runErr = err })
+			}
+		}()
+	}
+
+	wg.Wait()
+	return runErr
+}
+
+// mutexWriter serializes writes from concurrent pod log streams so their
+// lines don't interleave mid-write.
+type mutexWriter struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+func (m *mutexWriter) Write(p []byte) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.w.Write(p)
+}
+
+func streamPodLogs(out io.Writer, cs *cli.Clients, ns, podName string, opts *corev1.PodLogOptions, withPrefix bool) error {
+	req := cs.Kube.CoreV1().Pods(ns).GetLogs(podName, opts)
+	logs, err := req.Stream()
+	if err != nil {
+		return fmt.Errorf("failed to get logs for pod %q: %v", podName, err)
+	}
+	defer logs.Close()
+
+	if !withPrefix {
+		_, err = io.Copy(out, logs)
+		return err
+	}
+
+	return copyWithPrefix(out, logs, fmt.Sprintf("[%s]", podName))
+}
+
+func copyWithPrefix(out io.Writer, r io.Reader, prefix string) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(out, "%s %s\n", prefix, scanner.Text()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func readyPods(pods []corev1.Pod) []corev1.Pod {
+	var ready []corev1.Pod
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning {
+			ready = append(ready, pod)
+		}
+	}
+	return ready
+}
@@ -0,0 +1,70 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEventListenerLogs(t *testing.T) {
+	sinkPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "el-foo-6d8f9b-abcde",
+			Namespace: "ns",
+			Labels:    map[string]string{eventListenerPodSelector: "foo"},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		kube      *fake.Clientset
+		wantError bool
+	}{
+		{
+			name:      "Stream logs from the single sink pod",
+			command:   []string{"logs", "foo", "-n", "ns"},
+			kube:      fake.NewSimpleClientset(sinkPod),
+			wantError: false,
+		},
+		{
+			name:      "No sink pod found",
+			command:   []string{"logs", "missing", "-n", "ns"},
+			kube:      fake.NewSimpleClientset(),
+			wantError: true,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Kube: tp.kube}
+			el := Command(p)
+
+			_, err := test.ExecuteCommand(el, tp.command...)
+			if tp.wantError && err == nil {
+				t.Errorf("Error expected here")
+			}
+			if !tp.wantError && err != nil {
+				t.Errorf("Unexpected Error: %v", err)
+			}
+		})
+	}
+}
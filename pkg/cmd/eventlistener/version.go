@@ -0,0 +1,104 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// apiVersionPreference is the order in which served triggers.tekton.dev
+// versions are preferred, lowest to highest.
+var apiVersionPreference = []string{"v1alpha1", "v1beta1"}
+
+// eventListenerLister abstracts listing EventListeners across the
+// triggers.tekton.dev API versions this CLI understands. Results are
+// normalized to v1alpha1.EventListenerList. For v1beta1-served clusters
+// only ObjectMeta is carried over (Spec, including Triggers, is dropped by
+// the conversion) — enough for list's NAMESPACE/NAME/AGE table, while its
+// -o json|yaml output on such clusters carries no spec. A triggers-aware
+// consumer needs its own conversion.
+type eventListenerLister interface {
+	List(namespace string, opts metav1.ListOptions) (*v1alpha1.EventListenerList, error)
+}
+
+type v1alpha1EventListeners struct {
+	client versioned.Interface
+}
+
+func (l *v1alpha1EventListeners) List(namespace string, opts metav1.ListOptions) (*v1alpha1.EventListenerList, error) {
+	els, err := l.client.TektonV1alpha1().EventListeners(namespace).List(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: this is required for -o json|yaml to work properly since
+	// tektoncd go client fails to set these; probably a bug
+	els.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
+		Version: "triggers.tekton.dev/v1alpha1",
+		Kind:    "EventListenerList",
+	})
+	return els, nil
+}
+
+type v1beta1EventListeners struct {
+	client versioned.Interface
+}
+
+func (l *v1beta1EventListeners) List(namespace string, opts metav1.ListOptions) (*v1alpha1.EventListenerList, error) {
+	beta, err := l.client.TektonV1beta1().EventListeners(namespace).List(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &v1alpha1.EventListenerList{
+		Items: make([]v1alpha1.EventListener, 0, len(beta.Items)),
+	}
+	for _, el := range beta.Items {
+		out.Items = append(out.Items, v1alpha1.EventListener{ObjectMeta: el.ObjectMeta})
+	}
+	out.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{
+		Version: "triggers.tekton.dev/v1beta1",
+		Kind:    "EventListenerList",
+	})
+	return out, nil
+}
+
+// newEventListenerLister resolves the eventListenerLister to use:
+// apiVersion if explicitly set (via --api-version), otherwise the version
+// negotiated from the cluster's discovery API.
+func newEventListenerLister(p cli.Params, apiVersion string) (eventListenerLister, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+
+	version := apiVersion
+	if version == "" {
+		if v, err := cli.PreferredAPIVersion(cs.Discovery, "triggers.tekton.dev", apiVersionPreference); err == nil {
+			version = v
+		} else {
+			version = "v1alpha1"
+		}
+	}
+
+	if version == "v1beta1" {
+		return &v1beta1EventListeners{client: cs.Triggers}, nil
+	}
+	return &v1alpha1EventListeners{client: cs.Triggers}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"time"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionOp is an operation which modifies a Condition struct.
+type ConditionOp func(*v1alpha1.Condition)
+
+// Condition creates a Condition with the given name and options, following
+// the same builder pattern as tb.ClusterTask.
+func Condition(name string, ops ...ConditionOp) *v1alpha1.Condition {
+	cond := &v1alpha1.Condition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+
+	for _, op := range ops {
+		op(cond)
+	}
+
+	return cond
+}
+
+// ConditionNamespace sets the namespace on the Condition.
+func ConditionNamespace(ns string) ConditionOp {
+	return func(c *v1alpha1.Condition) {
+		c.Namespace = ns
+	}
+}
+
+// ConditionCreationTime sets the creation time on the Condition.
+func ConditionCreationTime(t time.Time) ConditionOp {
+	return func(c *v1alpha1.Condition) {
+		c.CreationTimestamp = metav1.Time{Time: t}
+	}
+}
+
+// ConditionCheck sets the check container image and command on the Condition.
+func ConditionCheck(image string, command ...string) ConditionOp {
+	return func(c *v1alpha1.Condition) {
+		c.Spec.Check = corev1.Container{
+			Image:   image,
+			Command: command,
+		}
+	}
+}
+
+// ConditionParam adds a param to the Condition spec.
+func ConditionParam(name, defaultValue string) ConditionOp {
+	return func(c *v1alpha1.Condition) {
+		c.Spec.Params = append(c.Spec.Params, v1alpha1.ParamSpec{
+			Name: name,
+			Type: v1alpha1.ParamTypeString,
+			Default: &v1alpha1.ArrayOrString{
+				Type:      v1alpha1.ParamTypeString,
+				StringVal: defaultValue,
+			},
+		})
+	}
+}